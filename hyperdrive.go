@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/goccy/go-json"
 )
@@ -13,6 +14,17 @@ var (
 	ErrMissingHyperdriveConfigID       = errors.New("required hyperdrive config id is missing")
 	ErrMissingHyperdriveConfigName     = errors.New("required hyperdrive config name is missing")
 	ErrMissingHyperdriveConfigPassword = errors.New("required hyperdrive config password is missing")
+
+	// ErrHyperdriveAuthFailed wraps the underlying API error when a request
+	// made with a HyperdriveAuth bearer token is rejected as unauthorized,
+	// so callers can distinguish it from ErrMissing* validation errors.
+	ErrHyperdriveAuthFailed = errors.New("hyperdrive auth token was rejected")
+
+	// ErrInvalidHyperdriveCaching wraps the specific rule a
+	// HyperdriveConfigCaching value violated, so callers (Terraform
+	// providers in particular) can surface an actionable diagnostic
+	// instead of an opaque 400 from the API.
+	ErrInvalidHyperdriveCaching = errors.New("invalid hyperdrive caching configuration")
 )
 
 type HyperdriveConfig struct {
@@ -36,6 +48,70 @@ type HyperdriveConfigCaching struct {
 	StaleWhileRevalidate int   `json:"stale_while_revalidate,omitempty"`
 }
 
+// Validate enforces the invariants the API itself enforces on caching
+// config, so callers get a local, actionable error instead of an opaque
+// 400 response: MaxAge and StaleWhileRevalidate must be non-negative,
+// StaleWhileRevalidate can be at most 10x MaxAge, and Disabled is mutually
+// exclusive with a nonzero MaxAge/StaleWhileRevalidate.
+func (c HyperdriveConfigCaching) Validate() error {
+	if c.Disabled != nil && *c.Disabled && (c.MaxAge != 0 || c.StaleWhileRevalidate != 0) {
+		return fmt.Errorf("%w: disabled is mutually exclusive with a nonzero max_age/stale_while_revalidate", ErrInvalidHyperdriveCaching)
+	}
+
+	if c.MaxAge < 0 {
+		return fmt.Errorf("%w: max_age must be >= 0", ErrInvalidHyperdriveCaching)
+	}
+
+	if c.StaleWhileRevalidate < 0 {
+		return fmt.Errorf("%w: stale_while_revalidate must be >= 0", ErrInvalidHyperdriveCaching)
+	}
+
+	if c.StaleWhileRevalidate > 10*c.MaxAge {
+		return fmt.Errorf("%w: stale_while_revalidate must be <= 10x max_age", ErrInvalidHyperdriveCaching)
+	}
+
+	return nil
+}
+
+// HyperdriveCachingPreset names a ready-made HyperdriveConfigCaching for a
+// common workload shape, for use with NewHyperdriveConfigCaching.
+type HyperdriveCachingPreset int
+
+const (
+	// CachingDisabled turns caching off entirely.
+	CachingDisabled HyperdriveCachingPreset = iota
+	// CachingConservative caches for 30s, serving stale for up to 60s.
+	CachingConservative
+	// CachingAggressive caches for 300s, serving stale for up to 600s.
+	CachingAggressive
+	// CachingReadHeavyOLTP caches briefly (15s/30s) for read-heavy
+	// transactional workloads that still need near-fresh data.
+	CachingReadHeavyOLTP
+	// CachingReporting caches for an hour, serving stale for up to two,
+	// for analytics/reporting workloads that tolerate staleness.
+	CachingReporting
+)
+
+// NewHyperdriveConfigCaching returns the HyperdriveConfigCaching for a
+// given preset.
+func NewHyperdriveConfigCaching(preset HyperdriveCachingPreset) HyperdriveConfigCaching {
+	switch preset {
+	case CachingDisabled:
+		disabled := true
+		return HyperdriveConfigCaching{Disabled: &disabled}
+	case CachingConservative:
+		return HyperdriveConfigCaching{MaxAge: 30, StaleWhileRevalidate: 60}
+	case CachingAggressive:
+		return HyperdriveConfigCaching{MaxAge: 300, StaleWhileRevalidate: 600}
+	case CachingReadHeavyOLTP:
+		return HyperdriveConfigCaching{MaxAge: 15, StaleWhileRevalidate: 30}
+	case CachingReporting:
+		return HyperdriveConfigCaching{MaxAge: 3600, StaleWhileRevalidate: 7200}
+	default:
+		return HyperdriveConfigCaching{}
+	}
+}
+
 type HyperdriveConfigListResponse struct {
 	Response
 	Result []HyperdriveConfig `json:"result"`
@@ -48,6 +124,11 @@ type CreateHyperdriveConfigParams struct {
 	Caching  HyperdriveConfigCaching `json:"caching,omitempty"`
 }
 
+// Validate checks params.Caching against HyperdriveConfigCaching.Validate.
+func (p CreateHyperdriveConfigParams) Validate() error {
+	return p.Caching.Validate()
+}
+
 type HyperdriveConfigResponse struct {
 	Response
 	Result HyperdriveConfig `json:"result"`
@@ -61,12 +142,38 @@ type UpdateHyperdriveConfigParams struct {
 	Caching      HyperdriveConfigCaching `json:"caching,omitempty"`
 }
 
+// Validate checks params.Caching against HyperdriveConfigCaching.Validate.
+func (p UpdateHyperdriveConfigParams) Validate() error {
+	return p.Caching.Validate()
+}
+
+// HyperdriveConfigUpdate describes a partial update to a Hyperdrive config.
+//
+// Unlike UpdateHyperdriveConfigParams, every field is optional: only the
+// fields that are non-nil (or, if UpdateMask is set, the fields named by
+// UpdateMask) are sent to the API, so callers can rotate a single value
+// such as Caching.MaxAge without re-supplying the origin password, which
+// the API never returns on reads.
+type HyperdriveConfigUpdate struct {
+	HyperdriveID string `json:"-"`
+
+	Name     *string                  `json:"name,omitempty"`
+	Password *string                  `json:"password,omitempty"`
+	Origin   *HyperdriveConfigOrigin  `json:"origin,omitempty"`
+	Caching  *HyperdriveConfigCaching `json:"caching,omitempty"`
+
+	// UpdateMask restricts the patch to the named fields, using
+	// dot-separated paths such as "caching.max_age" or "origin.host". When
+	// empty, every non-nil field above is sent.
+	UpdateMask []string `json:"-"`
+}
+
 type ListHyperdriveConfigParams struct{}
 
 // ListHyperdriveConfigs returns the Hyperdrive configs owned by an account.
 //
 // API reference: https://developers.cloudflare.com/api/operations/list-hyperdrive
-func (api *API) ListHyperdriveConfigs(ctx context.Context, rc *ResourceContainer, params ListHyperdriveConfigParams) ([]HyperdriveConfig, error) {
+func (api *API) ListHyperdriveConfigs(ctx context.Context, rc *ResourceContainer, params ListHyperdriveConfigParams, opts ...HyperdriveOption) ([]HyperdriveConfig, error) {
 	if rc.Identifier == "" {
 		return []HyperdriveConfig{}, ErrMissingAccountID
 	}
@@ -74,7 +181,7 @@ func (api *API) ListHyperdriveConfigs(ctx context.Context, rc *ResourceContainer
 	hResponse := HyperdriveConfigListResponse{}
 	uri := fmt.Sprintf("/accounts/%s/hyperdrive/configs", rc.Identifier)
 
-	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	res, err := api.hyperdriveRequest(ctx, http.MethodGet, uri, nil, opts)
 	if err != nil {
 		return []HyperdriveConfig{}, err
 	}
@@ -90,7 +197,7 @@ func (api *API) ListHyperdriveConfigs(ctx context.Context, rc *ResourceContainer
 // CreateHyperdriveConfig creates a new Hyperdrive config.
 //
 // API reference: https://developers.cloudflare.com/api/operations/create-hyperdrive
-func (api *API) CreateHyperdriveConfig(ctx context.Context, rc *ResourceContainer, params CreateHyperdriveConfigParams) (HyperdriveConfig, error) {
+func (api *API) CreateHyperdriveConfig(ctx context.Context, rc *ResourceContainer, params CreateHyperdriveConfigParams, opts ...HyperdriveOption) (HyperdriveConfig, error) {
 	if rc.Identifier == "" {
 		return HyperdriveConfig{}, ErrMissingAccountID
 	}
@@ -103,9 +210,13 @@ func (api *API) CreateHyperdriveConfig(ctx context.Context, rc *ResourceContaine
 		return HyperdriveConfig{}, ErrMissingHyperdriveConfigPassword
 	}
 
+	if err := params.Validate(); err != nil {
+		return HyperdriveConfig{}, err
+	}
+
 	uri := fmt.Sprintf("/accounts/%s/hyperdrive/configs", rc.Identifier)
 
-	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, params)
+	res, err := api.hyperdriveRequest(ctx, http.MethodPost, uri, params, opts)
 	if err != nil {
 		return HyperdriveConfig{}, err
 	}
@@ -122,7 +233,7 @@ func (api *API) CreateHyperdriveConfig(ctx context.Context, rc *ResourceContaine
 // DeleteHyperdriveConfig deletes a Hyperdrive config.
 //
 // API reference: https://developers.cloudflare.com/api/operations/delete-hyperdrive
-func (api *API) DeleteHyperdriveConfig(ctx context.Context, rc *ResourceContainer, hyperdriveID string) error {
+func (api *API) DeleteHyperdriveConfig(ctx context.Context, rc *ResourceContainer, hyperdriveID string, opts ...HyperdriveOption) error {
 	if rc.Identifier == "" {
 		return ErrMissingAccountID
 	}
@@ -131,7 +242,7 @@ func (api *API) DeleteHyperdriveConfig(ctx context.Context, rc *ResourceContaine
 	}
 
 	uri := fmt.Sprintf("/accounts/%s/hyperdrive/configs/%s", rc.Identifier, hyperdriveID)
-	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	_, err := api.hyperdriveRequest(ctx, http.MethodDelete, uri, nil, opts)
 	if err != nil {
 		return fmt.Errorf("%s: %w", errMakeRequestError, err)
 	}
@@ -142,7 +253,7 @@ func (api *API) DeleteHyperdriveConfig(ctx context.Context, rc *ResourceContaine
 // GetHyperdriveConfig returns a single Hyperdrive config based on the ID.
 //
 // API reference: https://developers.cloudflare.com/api/operations/get-hyperdrive
-func (api *API) GetHyperdriveConfig(ctx context.Context, rc *ResourceContainer, hyperdriveID string) (HyperdriveConfig, error) {
+func (api *API) GetHyperdriveConfig(ctx context.Context, rc *ResourceContainer, hyperdriveID string, opts ...HyperdriveOption) (HyperdriveConfig, error) {
 	if rc.Identifier == "" {
 		return HyperdriveConfig{}, ErrMissingAccountID
 	}
@@ -152,7 +263,7 @@ func (api *API) GetHyperdriveConfig(ctx context.Context, rc *ResourceContainer,
 	}
 
 	uri := fmt.Sprintf("/accounts/%s/hyperdrive/configs/%s", rc.Identifier, hyperdriveID)
-	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	res, err := api.hyperdriveRequest(ctx, http.MethodGet, uri, nil, opts)
 	if err != nil {
 		return HyperdriveConfig{}, err
 	}
@@ -169,7 +280,7 @@ func (api *API) GetHyperdriveConfig(ctx context.Context, rc *ResourceContainer,
 // UpdateHyperdriveConfig updates a Hyperdrive config.
 //
 // API reference: https://developers.cloudflare.com/api/operations/update-hyperdrive
-func (api *API) UpdateHyperdriveConfig(ctx context.Context, rc *ResourceContainer, params UpdateHyperdriveConfigParams) (HyperdriveConfig, error) {
+func (api *API) UpdateHyperdriveConfig(ctx context.Context, rc *ResourceContainer, params UpdateHyperdriveConfigParams, opts ...HyperdriveOption) (HyperdriveConfig, error) {
 	if rc.Identifier == "" {
 		return HyperdriveConfig{}, ErrMissingAccountID
 	}
@@ -178,9 +289,13 @@ func (api *API) UpdateHyperdriveConfig(ctx context.Context, rc *ResourceContaine
 		return HyperdriveConfig{}, ErrMissingHyperdriveConfigID
 	}
 
+	if err := params.Validate(); err != nil {
+		return HyperdriveConfig{}, err
+	}
+
 	uri := fmt.Sprintf("/accounts/%s/hyperdrive/configs/%s", rc.Identifier, params.HyperdriveID)
 
-	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, params)
+	res, err := api.hyperdriveRequest(ctx, http.MethodPut, uri, params, opts)
 	if err != nil {
 		return HyperdriveConfig{}, err
 	}
@@ -193,3 +308,273 @@ func (api *API) UpdateHyperdriveConfig(ctx context.Context, rc *ResourceContaine
 
 	return r.Result, nil
 }
+
+// PatchHyperdriveConfig applies a partial update to a Hyperdrive config,
+// sending only the fields set on params (or, if params.UpdateMask is set,
+// only the fields it names). This lets callers rotate a single value, such
+// as Caching.MaxAge, without re-supplying the origin password or the rest
+// of the config.
+//
+// Account tiers that don't yet support PATCH on this endpoint fall back to
+// a read-modify-write PUT; in that fallback, params.Password must still be
+// set, since the API never returns the stored password to merge in.
+func (api *API) PatchHyperdriveConfig(ctx context.Context, rc *ResourceContainer, params HyperdriveConfigUpdate, opts ...HyperdriveOption) (HyperdriveConfig, error) {
+	if rc.Identifier == "" {
+		return HyperdriveConfig{}, ErrMissingAccountID
+	}
+
+	if params.HyperdriveID == "" {
+		return HyperdriveConfig{}, ErrMissingHyperdriveConfigID
+	}
+
+	// Only validate Caching here when the whole object is being replaced:
+	// with a mask that selects a single nested field (e.g.
+	// "caching.stale_while_revalidate"), params.Caching's other fields read
+	// as their zero value rather than the config's current stored value, so
+	// checking it against Validate's whole-object invariants would reject
+	// patches against the zero value instead of the real merged result. The
+	// PUT fallback validates the correctly merged object itself, via
+	// UpdateHyperdriveConfig.
+	if params.Caching != nil && resolveHyperdriveMask(params).caching {
+		if err := params.Caching.Validate(); err != nil {
+			return HyperdriveConfig{}, err
+		}
+	}
+
+	payload := hyperdriveConfigPatchPayload(params)
+
+	uri := fmt.Sprintf("/accounts/%s/hyperdrive/configs/%s", rc.Identifier, params.HyperdriveID)
+
+	res, err := api.hyperdriveRequest(ctx, http.MethodPatch, uri, payload, opts)
+	if err != nil {
+		if isHyperdrivePatchUnsupported(err) {
+			return api.patchHyperdriveConfigViaPut(ctx, rc, params, opts)
+		}
+		return HyperdriveConfig{}, err
+	}
+
+	var r HyperdriveConfigResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return HyperdriveConfig{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
+
+// isHyperdrivePatchUnsupported reports whether err is the specific rejection
+// an account tier gives for PATCH on this endpoint not being supported yet,
+// as opposed to a transient failure, an auth failure, or a 404 on a missing
+// config. Only this case should fall back to a PUT.
+func isHyperdrivePatchUnsupported(err error) bool {
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.StatusCode == http.StatusMethodNotAllowed || apiErr.StatusCode == http.StatusNotImplemented
+}
+
+// patchHyperdriveConfigViaPut emulates PatchHyperdriveConfig on account
+// tiers that reject PATCH, by reading the current config and re-sending it
+// as a PUT with the same fields merged in that the PATCH would have sent.
+func (api *API) patchHyperdriveConfigViaPut(ctx context.Context, rc *ResourceContainer, params HyperdriveConfigUpdate, opts []HyperdriveOption) (HyperdriveConfig, error) {
+	mask := resolveHyperdriveMask(params)
+
+	// The PUT endpoint has no omitempty for password: it's always sent as
+	// part of the full resource. If the mask doesn't select it, there's no
+	// value to carry forward (the API never returns the stored password),
+	// so rather than guess, require the caller to pass the current password
+	// through the mask explicitly instead of silently rotating or leaving
+	// it unset.
+	if !mask.password {
+		return HyperdriveConfig{}, fmt.Errorf("%w: this account tier doesn't support PATCH, and the PUT fallback it uses instead always resends the password; include \"password\" in UpdateMask (or leave UpdateMask empty) with the current password set", ErrMissingHyperdriveConfigPassword)
+	}
+	if params.Password == nil {
+		return HyperdriveConfig{}, fmt.Errorf("%w: PUT fallback requires the password, which the API never returns on reads", ErrMissingHyperdriveConfigPassword)
+	}
+
+	current, err := api.GetHyperdriveConfig(ctx, rc, params.HyperdriveID, opts...)
+	if err != nil {
+		return HyperdriveConfig{}, fmt.Errorf("failed to read current hyperdrive config for patch fallback: %w", err)
+	}
+
+	updated := UpdateHyperdriveConfigParams{
+		HyperdriveID: params.HyperdriveID,
+		Name:         current.Name,
+		Password:     *params.Password,
+		Origin:       current.Origin,
+		Caching:      current.Caching,
+	}
+
+	if mask.name && params.Name != nil {
+		updated.Name = *params.Name
+	}
+
+	if params.Origin != nil {
+		if mask.origin {
+			updated.Origin = *params.Origin
+		} else {
+			for field := range mask.originFields {
+				switch field {
+				case "database":
+					updated.Origin.Database = params.Origin.Database
+				case "host":
+					updated.Origin.Host = params.Origin.Host
+				case "port":
+					updated.Origin.Port = params.Origin.Port
+				case "scheme":
+					updated.Origin.Scheme = params.Origin.Scheme
+				case "user":
+					updated.Origin.User = params.Origin.User
+				}
+			}
+		}
+	}
+
+	if params.Caching != nil {
+		if mask.caching {
+			updated.Caching = *params.Caching
+		} else {
+			for field := range mask.cachingFields {
+				switch field {
+				case "max_age":
+					updated.Caching.MaxAge = params.Caching.MaxAge
+				case "stale_while_revalidate":
+					updated.Caching.StaleWhileRevalidate = params.Caching.StaleWhileRevalidate
+				case "disabled":
+					updated.Caching.Disabled = params.Caching.Disabled
+				}
+			}
+		}
+	}
+
+	return api.UpdateHyperdriveConfig(ctx, rc, updated, opts...)
+}
+
+// hyperdriveMask resolves which fields of a HyperdriveConfigUpdate are
+// actually selected for the patch, so PatchHyperdriveConfig and its PUT
+// fallback apply the exact same fields.
+type hyperdriveMask struct {
+	name, password, origin, caching bool
+	originFields                    map[string]bool
+	cachingFields                   map[string]bool
+}
+
+// resolveHyperdriveMask interprets params.UpdateMask: an empty mask selects
+// every non-nil top-level field in full, otherwise each dot-separated path
+// selects either a whole top-level field ("origin") or a single nested one
+// ("origin.host").
+func resolveHyperdriveMask(params HyperdriveConfigUpdate) hyperdriveMask {
+	m := hyperdriveMask{originFields: map[string]bool{}, cachingFields: map[string]bool{}}
+
+	if len(params.UpdateMask) == 0 {
+		m.name = params.Name != nil
+		m.password = params.Password != nil
+		m.origin = params.Origin != nil
+		m.caching = params.Caching != nil
+		return m
+	}
+
+	for _, path := range params.UpdateMask {
+		field, nested, hasNested := strings.Cut(path, ".")
+		switch field {
+		case "name":
+			m.name = true
+		case "password":
+			m.password = true
+		case "origin":
+			if hasNested {
+				m.originFields[nested] = true
+			} else {
+				m.origin = true
+			}
+		case "caching":
+			if hasNested {
+				m.cachingFields[nested] = true
+			} else {
+				m.caching = true
+			}
+		}
+	}
+
+	return m
+}
+
+// hyperdriveConfigPatchPayload builds the JSON body for PatchHyperdriveConfig
+// from params, restricted to the fields resolveHyperdriveMask selects.
+//
+// It builds origin/caching from their typed pointer fields directly rather
+// than marshalling through their own omitempty tags: those tags exist to
+// keep the full-config wire format compact, but here they'd silently drop
+// a masked field whose value happens to be the zero value (e.g.
+// UpdateMask: ["caching.max_age"] with MaxAge: 0), which is exactly the
+// precise-single-field patch this is for.
+func hyperdriveConfigPatchPayload(params HyperdriveConfigUpdate) map[string]any {
+	mask := resolveHyperdriveMask(params)
+	payload := map[string]any{}
+
+	if mask.name && params.Name != nil {
+		payload["name"] = *params.Name
+	}
+	if mask.password && params.Password != nil {
+		payload["password"] = *params.Password
+	}
+
+	if params.Origin != nil {
+		origin := hyperdriveOriginToMap(params.Origin)
+		if mask.origin {
+			payload["origin"] = origin
+		} else if sub := hyperdriveSelectFields(origin, mask.originFields); len(sub) > 0 {
+			payload["origin"] = sub
+		}
+	}
+
+	if params.Caching != nil {
+		caching := hyperdriveCachingToMap(params.Caching)
+		if mask.caching {
+			payload["caching"] = caching
+		} else if sub := hyperdriveSelectFields(caching, mask.cachingFields); len(sub) > 0 {
+			payload["caching"] = sub
+		}
+	}
+
+	return payload
+}
+
+// hyperdriveSelectFields returns the subset of full named by selected.
+func hyperdriveSelectFields(full map[string]any, selected map[string]bool) map[string]any {
+	sub := map[string]any{}
+	for field := range selected {
+		if v, ok := full[field]; ok {
+			sub[field] = v
+		}
+	}
+	return sub
+}
+
+// hyperdriveOriginToMap lists every HyperdriveConfigOrigin field explicitly,
+// so a masked zero value (e.g. Port: 0) survives into the patch payload.
+func hyperdriveOriginToMap(o *HyperdriveConfigOrigin) map[string]any {
+	return map[string]any{
+		"database": o.Database,
+		"host":     o.Host,
+		"port":     o.Port,
+		"scheme":   o.Scheme,
+		"user":     o.User,
+	}
+}
+
+// hyperdriveCachingToMap lists every HyperdriveConfigCaching field
+// explicitly, so a masked zero value (e.g. MaxAge: 0) survives into the
+// patch payload. Disabled is only included when the caller set it, since
+// as a *bool its zero value is "unspecified", not "false".
+func hyperdriveCachingToMap(c *HyperdriveConfigCaching) map[string]any {
+	m := map[string]any{
+		"max_age":                c.MaxAge,
+		"stale_while_revalidate": c.StaleWhileRevalidate,
+	}
+	if c.Disabled != nil {
+		m["disabled"] = *c.Disabled
+	}
+	return m
+}
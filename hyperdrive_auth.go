@@ -0,0 +1,230 @@
+package cloudflare
+
+import (
+	"context"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// HyperdriveAuthScope restricts what a minted Hyperdrive bearer token is
+// allowed to do.
+type HyperdriveAuthScope string
+
+const (
+	HyperdriveAuthScopeRead  HyperdriveAuthScope = "read"
+	HyperdriveAuthScopeWrite HyperdriveAuthScope = "write"
+)
+
+// HyperdriveTokenSource mints the bearer token for a single Hyperdrive API
+// call. Implementations are free to sign locally (see HyperdriveJWTSigner)
+// or fetch from an external authority such as Vault.
+type HyperdriveTokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// HyperdriveAuth carries a per-call bearer token that overrides the
+// account-wide API token normally attached by *API, so that a Hyperdrive
+// client driven from an untrusted middle tier can hand out short-lived,
+// scoped credentials instead of the shared token.
+type HyperdriveAuth struct {
+	TokenSource HyperdriveTokenSource
+}
+
+// HyperdriveOption configures a single Hyperdrive API call.
+type HyperdriveOption func(*hyperdriveCallOptions)
+
+type hyperdriveCallOptions struct {
+	auth *HyperdriveAuth
+}
+
+// WithHyperdriveAuth overrides the account-wide API token for this call with
+// a bearer token minted by auth.TokenSource.
+func WithHyperdriveAuth(auth HyperdriveAuth) HyperdriveOption {
+	return func(o *hyperdriveCallOptions) {
+		o.auth = &auth
+	}
+}
+
+func resolveHyperdriveCallOptions(opts []HyperdriveOption) *hyperdriveCallOptions {
+	o := &hyperdriveCallOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// SetDefaultHyperdriveAuth sets the HyperdriveAuth applied to Hyperdrive API
+// calls made with api that don't pass WithHyperdriveAuth explicitly. It is
+// safe to call concurrently with Hyperdrive API calls made on the same api.
+func (api *API) SetDefaultHyperdriveAuth(auth HyperdriveAuth) {
+	api.hyperdriveAuthMu.Lock()
+	defer api.hyperdriveAuthMu.Unlock()
+	api.hyperdriveAuth = &auth
+}
+
+func (api *API) defaultHyperdriveAuth() *HyperdriveAuth {
+	api.hyperdriveAuthMu.Lock()
+	defer api.hyperdriveAuthMu.Unlock()
+	return api.hyperdriveAuth
+}
+
+// hyperdriveRequest issues a Hyperdrive API request, attaching a bearer
+// token from the resolved HyperdriveAuth (per-call, falling back to the
+// api's default) in place of the account-wide API token. Unauthorized
+// responses are wrapped in ErrHyperdriveAuthFailed so callers can tell an
+// auth failure apart from a missing-resource error.
+func (api *API) hyperdriveRequest(ctx context.Context, method, uri string, body any, opts []HyperdriveOption) ([]byte, error) {
+	auth := resolveHyperdriveCallOptions(opts).auth
+	if auth == nil {
+		auth = api.defaultHyperdriveAuth()
+	}
+
+	var res []byte
+	var err error
+
+	if auth != nil && auth.TokenSource != nil {
+		token, tokenErr := auth.TokenSource.Token(ctx)
+		if tokenErr != nil {
+			return nil, fmt.Errorf("failed to mint hyperdrive auth token: %w", tokenErr)
+		}
+
+		headers := make(http.Header)
+		headers.Set("Authorization", "Bearer "+token)
+		res, err = api.makeRequestContextWithHeaders(ctx, method, uri, body, headers)
+	} else {
+		res, err = api.makeRequestContext(ctx, method, uri, body)
+	}
+
+	if err != nil {
+		if isHyperdriveUnauthorized(err) {
+			return nil, fmt.Errorf("%w: %w", ErrHyperdriveAuthFailed, err)
+		}
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func isHyperdriveUnauthorized(err error) bool {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusUnauthorized
+	}
+	return false
+}
+
+// HyperdriveJWTClaims are the claims minted for a Hyperdrive bearer token.
+type HyperdriveJWTClaims struct {
+	jwt.RegisteredClaims
+	HyperdriveID string   `json:"hyperdrive_id,omitempty"`
+	Scope        []string `json:"scope,omitempty"`
+}
+
+// HyperdriveJWTSigner is a HyperdriveTokenSource that signs short-lived,
+// scoped tokens locally with HS256 (Key) or RS256 (RSAKey). Exactly one of
+// Key or RSAKey must be set.
+type HyperdriveJWTSigner struct {
+	Subject      string
+	HyperdriveID string
+	Scope        []HyperdriveAuthScope
+
+	// TTL defaults to 5 minutes.
+	TTL time.Duration
+
+	Key    []byte
+	RSAKey *rsa.PrivateKey
+}
+
+func (s *HyperdriveJWTSigner) Token(ctx context.Context) (string, error) {
+	ttl := s.TTL
+	if ttl <= 0 {
+		ttl = 5 * time.Minute
+	}
+
+	now := time.Now()
+	scope := make([]string, len(s.Scope))
+	for i, sc := range s.Scope {
+		scope[i] = string(sc)
+	}
+
+	claims := HyperdriveJWTClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   s.Subject,
+			Audience:  jwt.ClaimStrings{"hyperdrive"},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+		HyperdriveID: s.HyperdriveID,
+		Scope:        scope,
+	}
+
+	switch {
+	case s.RSAKey != nil:
+		return jwt.NewWithClaims(jwt.SigningMethodRS256, claims).SignedString(s.RSAKey)
+	case len(s.Key) > 0:
+		return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.Key)
+	default:
+		return "", errors.New("cloudflare: HyperdriveJWTSigner requires a Key (HS256) or RSAKey (RS256)")
+	}
+}
+
+// HyperdriveTokenRefresher mints a Hyperdrive bearer token from an external
+// authority, such as Vault, instead of signing one locally.
+type HyperdriveTokenRefresher interface {
+	RefreshHyperdriveToken(ctx context.Context, subject, hyperdriveID string, scope []HyperdriveAuthScope) (token string, expiresAt time.Time, err error)
+}
+
+// CachingHyperdriveTokenSource wraps a HyperdriveTokenRefresher and reuses
+// the minted token across calls, refreshing it once the RefreshWindow
+// fraction of its TTL has elapsed since it was minted (default: refresh at
+// 80% of its TTL).
+type CachingHyperdriveTokenSource struct {
+	Refresher    HyperdriveTokenRefresher
+	Subject      string
+	HyperdriveID string
+	Scope        []HyperdriveAuthScope
+
+	// RefreshWindow is the fraction of the token's TTL, counted from
+	// mint time, after which Token mints a replacement. Defaults to 0.8.
+	RefreshWindow float64
+
+	mu        sync.Mutex
+	token     string
+	mintedAt  time.Time
+	expiresAt time.Time
+}
+
+func (c *CachingHyperdriveTokenSource) Token(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.token != "" && time.Now().Before(c.refreshAt()) {
+		return c.token, nil
+	}
+
+	token, expiresAt, err := c.Refresher.RefreshHyperdriveToken(ctx, c.Subject, c.HyperdriveID, c.Scope)
+	if err != nil {
+		return "", fmt.Errorf("failed to refresh hyperdrive token: %w", err)
+	}
+
+	c.token = token
+	c.mintedAt = time.Now()
+	c.expiresAt = expiresAt
+
+	return c.token, nil
+}
+
+func (c *CachingHyperdriveTokenSource) refreshAt() time.Time {
+	window := c.RefreshWindow
+	if window <= 0 {
+		window = 0.8
+	}
+	ttl := c.expiresAt.Sub(c.mintedAt)
+	return c.mintedAt.Add(time.Duration(float64(ttl) * window))
+}
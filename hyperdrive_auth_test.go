@@ -0,0 +1,89 @@
+package cloudflare
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPI_DefaultHyperdriveAuth(t *testing.T) {
+	api := &API{}
+	assert.Nil(t, api.defaultHyperdriveAuth())
+
+	auth := HyperdriveAuth{TokenSource: &HyperdriveJWTSigner{Key: []byte("secret")}}
+	api.SetDefaultHyperdriveAuth(auth)
+
+	got := api.defaultHyperdriveAuth()
+	if assert.NotNil(t, got) {
+		assert.Same(t, auth.TokenSource, got.TokenSource)
+	}
+}
+
+func TestHyperdriveJWTSigner(t *testing.T) {
+	t.Run("HS256", func(t *testing.T) {
+		signer := &HyperdriveJWTSigner{Subject: "worker-1", HyperdriveID: "hd1", Key: []byte("secret")}
+
+		token, err := signer.Token(context.Background())
+		if assert.NoError(t, err) {
+			parsed, err := jwt.ParseWithClaims(token, &HyperdriveJWTClaims{}, func(*jwt.Token) (any, error) {
+				return []byte("secret"), nil
+			})
+			if assert.NoError(t, err) {
+				claims := parsed.Claims.(*HyperdriveJWTClaims)
+				assert.Equal(t, "worker-1", claims.Subject)
+				assert.Equal(t, "hd1", claims.HyperdriveID)
+			}
+		}
+	})
+
+	t.Run("RS256", func(t *testing.T) {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		assert.NoError(t, err)
+
+		signer := &HyperdriveJWTSigner{Subject: "worker-1", RSAKey: key}
+		token, err := signer.Token(context.Background())
+		if assert.NoError(t, err) {
+			_, err := jwt.ParseWithClaims(token, &HyperdriveJWTClaims{}, func(*jwt.Token) (any, error) {
+				return &key.PublicKey, nil
+			})
+			assert.NoError(t, err)
+		}
+	})
+
+	t.Run("requires a key", func(t *testing.T) {
+		signer := &HyperdriveJWTSigner{Subject: "worker-1"}
+		_, err := signer.Token(context.Background())
+		assert.Error(t, err)
+	})
+}
+
+type fakeHyperdriveTokenRefresher struct {
+	calls int
+}
+
+func (f *fakeHyperdriveTokenRefresher) RefreshHyperdriveToken(ctx context.Context, subject, hyperdriveID string, scope []HyperdriveAuthScope) (string, time.Time, error) {
+	f.calls++
+	return "token", time.Now().Add(time.Minute), nil
+}
+
+func TestCachingHyperdriveTokenSource(t *testing.T) {
+	refresher := &fakeHyperdriveTokenRefresher{}
+	source := &CachingHyperdriveTokenSource{Refresher: refresher}
+
+	_, err := source.Token(context.Background())
+	assert.NoError(t, err)
+	_, err = source.Token(context.Background())
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, refresher.calls, "expected the cached token to be reused")
+
+	source.expiresAt = time.Now().Add(-time.Second)
+	_, err = source.Token(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 2, refresher.calls, "expected a near-expiry token to be refreshed")
+}
@@ -0,0 +1,71 @@
+package cloudflare
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHyperdriveConfigCaching_Validate(t *testing.T) {
+	disabled := true
+
+	tests := map[string]struct {
+		caching HyperdriveConfigCaching
+		wantErr bool
+	}{
+		"zero value is valid":                      {caching: HyperdriveConfigCaching{}},
+		"disabled alone is valid":                  {caching: HyperdriveConfigCaching{Disabled: &disabled}},
+		"disabled with nonzero max_age is invalid": {caching: HyperdriveConfigCaching{Disabled: &disabled, MaxAge: 30}, wantErr: true},
+		"negative max_age is invalid":              {caching: HyperdriveConfigCaching{MaxAge: -1}, wantErr: true},
+		"negative stale_while_revalidate is invalid": {
+			caching: HyperdriveConfigCaching{MaxAge: 30, StaleWhileRevalidate: -1}, wantErr: true,
+		},
+		"stale_while_revalidate beyond 10x max_age is invalid": {
+			caching: HyperdriveConfigCaching{MaxAge: 30, StaleWhileRevalidate: 301}, wantErr: true,
+		},
+		"stale_while_revalidate at the 10x boundary is valid": {
+			caching: HyperdriveConfigCaching{MaxAge: 30, StaleWhileRevalidate: 300},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.caching.Validate()
+			if tc.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidHyperdriveCaching)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNewHyperdriveConfigCaching(t *testing.T) {
+	tests := map[string]struct {
+		preset HyperdriveCachingPreset
+		want   HyperdriveConfigCaching
+	}{
+		"disabled":        {preset: CachingDisabled, want: HyperdriveConfigCaching{Disabled: BoolPtr(true)}},
+		"conservative":    {preset: CachingConservative, want: HyperdriveConfigCaching{MaxAge: 30, StaleWhileRevalidate: 60}},
+		"aggressive":      {preset: CachingAggressive, want: HyperdriveConfigCaching{MaxAge: 300, StaleWhileRevalidate: 600}},
+		"read heavy OLTP": {preset: CachingReadHeavyOLTP, want: HyperdriveConfigCaching{MaxAge: 15, StaleWhileRevalidate: 30}},
+		"reporting":       {preset: CachingReporting, want: HyperdriveConfigCaching{MaxAge: 3600, StaleWhileRevalidate: 7200}},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := NewHyperdriveConfigCaching(tc.preset)
+			assert.Equal(t, tc.want.MaxAge, got.MaxAge)
+			assert.Equal(t, tc.want.StaleWhileRevalidate, got.StaleWhileRevalidate)
+			if tc.want.Disabled != nil {
+				if assert.NotNil(t, got.Disabled) {
+					assert.Equal(t, *tc.want.Disabled, *got.Disabled)
+				}
+			} else {
+				assert.Nil(t, got.Disabled)
+			}
+
+			assert.NoError(t, got.Validate())
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+var (
+	ErrMissingHyperdriveProbeTarget = errors.New("probe requires either an existing hyperdrive id or an inline origin")
+	// ErrAmbiguousHyperdriveProbeTarget is returned when both HyperdriveID
+	// and Origin are set: a probe validates one origin, so only one of the
+	// two ways to name it may be used at a time.
+	ErrAmbiguousHyperdriveProbeTarget = errors.New("probe requires a hyperdrive id or an inline origin, not both")
+)
+
+// ProbeHyperdriveOriginParams describes the origin database to validate
+// connectivity against: either an existing HyperdriveID, or an inline
+// Origin (with its Password, which is never stored). Exactly one of
+// HyperdriveID or Origin must be set.
+type ProbeHyperdriveOriginParams struct {
+	HyperdriveID string
+	Origin       *HyperdriveConfigOrigin
+	Password     string
+
+	// Timeout bounds how long the edge waits on the origin before giving
+	// up. Defaults to the API's own probe timeout when zero.
+	Timeout time.Duration
+
+	// Queries are simple "SELECT 1"-style checks run against the origin
+	// in addition to the connectivity check.
+	Queries []string
+}
+
+// HyperdriveProbeErrorCode is a stable reason code for a failed probe.
+type HyperdriveProbeErrorCode string
+
+const (
+	HyperdriveProbeErrorDNSFailure    HyperdriveProbeErrorCode = "DNS_FAILURE"
+	HyperdriveProbeErrorTLSFailure    HyperdriveProbeErrorCode = "TLS_FAILURE"
+	HyperdriveProbeErrorAuthFailure   HyperdriveProbeErrorCode = "AUTH_FAILURE"
+	HyperdriveProbeErrorTimeout       HyperdriveProbeErrorCode = "TIMEOUT"
+	HyperdriveProbeErrorPoolExhausted HyperdriveProbeErrorCode = "POOL_EXHAUSTED"
+)
+
+// HyperdriveProbeError explains why a probe found the origin unreachable.
+type HyperdriveProbeError struct {
+	Code    HyperdriveProbeErrorCode `json:"code"`
+	Message string                   `json:"message,omitempty"`
+}
+
+func (e *HyperdriveProbeError) Error() string {
+	if e == nil {
+		return ""
+	}
+	if e.Message == "" {
+		return string(e.Code)
+	}
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// HyperdriveProbeResult reports whether an origin was reachable from
+// Cloudflare's edge, and some basic timing/identity information collected
+// along the way.
+type HyperdriveProbeResult struct {
+	Reachable      bool                  `json:"reachable"`
+	LatencyMs      int                   `json:"latency_ms"`
+	TLSHandshakeMs int                   `json:"tls_handshake_ms"`
+	ServerVersion  string                `json:"server_version,omitempty"`
+	ResolvedIPs    []string              `json:"resolved_ips,omitempty"`
+	Error          *HyperdriveProbeError `json:"error,omitempty"`
+}
+
+type hyperdriveProbeResponse struct {
+	Response
+	Result HyperdriveProbeResult `json:"result"`
+}
+
+// ProbeHyperdriveOrigin validates that an origin database is reachable from
+// Cloudflare's edge, either for an existing Hyperdrive config or for an
+// inline origin that hasn't been saved yet. This lets callers (and
+// Terraform providers in particular) catch a bad host or password before
+// persisting a config, rather than discovering it later when Workers start
+// failing to connect.
+//
+// This targets a probe endpoint that isn't in Cloudflare's published
+// Hyperdrive API reference at the time of writing; treat it as forward
+// compatible rather than guaranteed, and expect a plain 404 wrapped as the
+// returned error on accounts/API versions where it isn't enabled yet.
+func (api *API) ProbeHyperdriveOrigin(ctx context.Context, rc *ResourceContainer, params ProbeHyperdriveOriginParams, opts ...HyperdriveOption) (HyperdriveProbeResult, error) {
+	if rc.Identifier == "" {
+		return HyperdriveProbeResult{}, ErrMissingAccountID
+	}
+
+	if params.HyperdriveID == "" && params.Origin == nil {
+		return HyperdriveProbeResult{}, ErrMissingHyperdriveProbeTarget
+	}
+
+	if params.HyperdriveID != "" && params.Origin != nil {
+		return HyperdriveProbeResult{}, ErrAmbiguousHyperdriveProbeTarget
+	}
+
+	payload := map[string]any{}
+
+	if params.HyperdriveID != "" {
+		payload["origin_id"] = params.HyperdriveID
+	}
+
+	if params.Origin != nil {
+		// Use the same explicit, zero-value-preserving serializer as the
+		// config patch path so a zero Port or empty Scheme in an inline
+		// origin reaches the probe the same way it would reach a saved
+		// config, rather than being dropped by omitempty.
+		payload["origin"] = hyperdriveOriginToMap(params.Origin)
+
+		if params.Password != "" {
+			payload["password"] = params.Password
+		}
+	}
+
+	if params.Timeout > 0 {
+		payload["timeout_ms"] = params.Timeout.Milliseconds()
+	}
+
+	if len(params.Queries) > 0 {
+		payload["queries"] = params.Queries
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/hyperdrive/configs/probe", rc.Identifier)
+
+	res, err := api.hyperdriveRequest(ctx, http.MethodPost, uri, payload, opts)
+	if err != nil {
+		return HyperdriveProbeResult{}, err
+	}
+
+	var r hyperdriveProbeResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return HyperdriveProbeResult{}, fmt.Errorf("%s: %w", errUnmarshalError, err)
+	}
+
+	return r.Result, nil
+}
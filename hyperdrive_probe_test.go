@@ -0,0 +1,56 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProbeHyperdriveOrigin_RejectsAmbiguousOrMissingTarget(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.ProbeHyperdriveOrigin(context.Background(), AccountIdentifier(testAccountID), ProbeHyperdriveOriginParams{})
+	assert.ErrorIs(t, err, ErrMissingHyperdriveProbeTarget)
+
+	_, err = client.ProbeHyperdriveOrigin(context.Background(), AccountIdentifier(testAccountID), ProbeHyperdriveOriginParams{
+		HyperdriveID: "023e105f4ecef8ad9ca31a8372d0c353",
+		Origin:       &HyperdriveConfigOrigin{Host: "origin.example.com"},
+	})
+	assert.ErrorIs(t, err, ErrAmbiguousHyperdriveProbeTarget)
+}
+
+func TestProbeHyperdriveOrigin_EncodesInlineOrigin(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var gotBody map[string]any
+
+	mux.HandleFunc(fmt.Sprintf("/accounts/%s/hyperdrive/configs/probe", testAccountID), func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&gotBody))
+		fmt.Fprint(w, `{"success": true, "result": {"reachable": true, "latency_ms": 12, "tls_handshake_ms": 4}}`)
+	})
+
+	out, err := client.ProbeHyperdriveOrigin(context.Background(), AccountIdentifier(testAccountID), ProbeHyperdriveOriginParams{
+		Origin:   &HyperdriveConfigOrigin{Database: "postgres", Host: "origin.example.com", Port: 0, Scheme: "postgres", User: "dbuser"},
+		Password: "s3cr3t",
+	})
+
+	if assert.NoError(t, err) {
+		assert.True(t, out.Reachable)
+
+		origin, ok := gotBody["origin"].(map[string]any)
+		if assert.True(t, ok, "expected an origin object in the request body") {
+			// port is a deliberate zero value: it must survive encoding
+			// rather than being dropped by an omitempty round-trip.
+			assert.Contains(t, origin, "port")
+			assert.EqualValues(t, 0, origin["port"])
+		}
+		assert.Equal(t, "s3cr3t", gotBody["password"])
+	}
+}
@@ -0,0 +1,171 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveHyperdriveMask(t *testing.T) {
+	tests := map[string]struct {
+		params HyperdriveConfigUpdate
+		want   hyperdriveMask
+	}{
+		"empty mask selects every set top-level field": {
+			params: HyperdriveConfigUpdate{
+				Name:    StringPtr("db"),
+				Caching: &HyperdriveConfigCaching{},
+			},
+			want: hyperdriveMask{name: true, caching: true, originFields: map[string]bool{}, cachingFields: map[string]bool{}},
+		},
+		"explicit mask selects only named fields": {
+			params: HyperdriveConfigUpdate{UpdateMask: []string{"origin.host", "caching"}},
+			want: hyperdriveMask{
+				caching:       true,
+				originFields:  map[string]bool{"host": true},
+				cachingFields: map[string]bool{},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := resolveHyperdriveMask(tc.params)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestHyperdriveConfigPatchPayload(t *testing.T) {
+	tests := map[string]struct {
+		params HyperdriveConfigUpdate
+		want   map[string]any
+	}{
+		"masked zero value survives": {
+			params: HyperdriveConfigUpdate{
+				UpdateMask: []string{"origin.port"},
+				Origin:     &HyperdriveConfigOrigin{Port: 0},
+			},
+			want: map[string]any{"origin": map[string]any{"port": 0}},
+		},
+		"unselected caching field is dropped": {
+			params: HyperdriveConfigUpdate{
+				UpdateMask: []string{"caching.max_age"},
+				Caching:    &HyperdriveConfigCaching{MaxAge: 30, StaleWhileRevalidate: 15},
+			},
+			want: map[string]any{"caching": map[string]any{"max_age": 30}},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, hyperdriveConfigPatchPayload(tc.params))
+		})
+	}
+}
+
+func TestPatchHyperdriveConfigViaPut_RequiresPasswordMask(t *testing.T) {
+	setup()
+	defer teardown()
+
+	const hyperdriveID = "023e105f4ecef8ad9ca31a8372d0c353"
+
+	mux.HandleFunc(fmt.Sprintf("/accounts/%s/hyperdrive/configs/%s", testAccountID, hyperdriveID), func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		fmt.Fprint(w, `{"success":false,"errors":[{"code":1000,"message":"patch not supported on this tier"}]}`)
+	})
+
+	_, err := client.PatchHyperdriveConfig(context.Background(), AccountIdentifier(testAccountID), HyperdriveConfigUpdate{
+		HyperdriveID: hyperdriveID,
+		UpdateMask:   []string{"caching.max_age"},
+		Caching:      &HyperdriveConfigCaching{MaxAge: 30},
+	})
+
+	assert.ErrorIs(t, err, ErrMissingHyperdriveConfigPassword)
+}
+
+func TestPatchHyperdriveConfig_DoesNotValidateUnselectedCachingFields(t *testing.T) {
+	setup()
+	defer teardown()
+
+	const hyperdriveID = "023e105f4ecef8ad9ca31a8372d0c353"
+
+	mux.HandleFunc(fmt.Sprintf("/accounts/%s/hyperdrive/configs/%s", testAccountID, hyperdriveID), func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		fmt.Fprint(w, `{
+			"success": true,
+			"result": {
+				"id": "023e105f4ecef8ad9ca31a8372d0c353",
+				"name": "example",
+				"origin": {"database": "postgres", "host": "origin.example.com", "port": 5432, "scheme": "postgres", "user": "dbuser"},
+				"caching": {"max_age": 60, "stale_while_revalidate": 100}
+			}
+		}`)
+	})
+
+	// StaleWhileRevalidate: 100 is only invalid against a zero MaxAge
+	// (params.Caching's unselected fields read as their zero value); the
+	// stored config's actual MaxAge of 60 makes it perfectly valid, and
+	// this patch must not be rejected against the unmerged zero value.
+	_, err := client.PatchHyperdriveConfig(context.Background(), AccountIdentifier(testAccountID), HyperdriveConfigUpdate{
+		HyperdriveID: hyperdriveID,
+		UpdateMask:   []string{"caching.stale_while_revalidate"},
+		Caching:      &HyperdriveConfigCaching{StaleWhileRevalidate: 100},
+	})
+
+	assert.NoError(t, err)
+}
+
+func TestPatchHyperdriveConfig_FallsBackToPutOnMethodNotAllowed(t *testing.T) {
+	setup()
+	defer teardown()
+
+	const hyperdriveID = "023e105f4ecef8ad9ca31a8372d0c353"
+	var sawPut bool
+
+	mux.HandleFunc(fmt.Sprintf("/accounts/%s/hyperdrive/configs/%s", testAccountID, hyperdriveID), func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPatch:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			fmt.Fprint(w, `{"success":false,"errors":[{"code":1000,"message":"patch not supported on this tier"}]}`)
+		case http.MethodGet:
+			fmt.Fprint(w, `{
+				"success": true,
+				"result": {
+					"id": "023e105f4ecef8ad9ca31a8372d0c353",
+					"name": "example",
+					"origin": {"database": "postgres", "host": "origin.example.com", "port": 5432, "scheme": "postgres", "user": "dbuser"},
+					"caching": {"max_age": 60, "stale_while_revalidate": 15}
+				}
+			}`)
+		case http.MethodPut:
+			sawPut = true
+			fmt.Fprint(w, `{
+				"success": true,
+				"result": {
+					"id": "023e105f4ecef8ad9ca31a8372d0c353",
+					"name": "example",
+					"origin": {"database": "postgres", "host": "origin.example.com", "port": 5432, "scheme": "postgres", "user": "dbuser"},
+					"caching": {"max_age": 120, "stale_while_revalidate": 15}
+				}
+			}`)
+		default:
+			t.Fatalf("unexpected method %s", r.Method)
+		}
+	})
+
+	out, err := client.PatchHyperdriveConfig(context.Background(), AccountIdentifier(testAccountID), HyperdriveConfigUpdate{
+		HyperdriveID: hyperdriveID,
+		UpdateMask:   []string{"password", "caching.max_age"},
+		Password:     StringPtr("s3cr3t"),
+		Caching:      &HyperdriveConfigCaching{MaxAge: 120},
+	})
+
+	if assert.NoError(t, err) {
+		assert.True(t, sawPut, "expected the PUT fallback to be used")
+		assert.Equal(t, 120, out.Caching.MaxAge)
+	}
+}